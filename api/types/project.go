@@ -42,6 +42,10 @@ type Project struct {
 	// specific project are considered deactivate for housekeeping.
 	ClientDeactivateThreshold string `bson:"client_deactivate_threshold"`
 
+	// DeactivationDisabled is whether the clients in this project are
+	// excluded from the deactivation of housekeeping.
+	DeactivationDisabled bool `json:"deactivation_disabled"`
+
 	// PublicKey is the API key of this project.
 	PublicKey string `json:"public_key"`
 
@@ -41,11 +41,18 @@ type UpdatableProjectFields struct {
 
 	// ClientDeactivateThreshold is the time after which clients in specific project are considered deactivate.
 	ClientDeactivateThreshold *string `bson:"client_deactivate_threshold,omitempty" validate:"omitempty,min=2,duration"`
+
+	// DeactivationDisabled is whether the clients in this project are excluded from housekeeping deactivation.
+	DeactivationDisabled *bool `bson:"deactivation_disabled,omitempty"`
 }
 
 // Validate validates the UpdatableProjectFields.
 func (i *UpdatableProjectFields) Validate() error {
-	if i.Name == nil && i.AuthWebhookURL == nil && i.AuthWebhookMethods == nil && i.ClientDeactivateThreshold == nil {
+	if i.Name == nil &&
+		i.AuthWebhookURL == nil &&
+		i.AuthWebhookMethods == nil &&
+		i.ClientDeactivateThreshold == nil &&
+		i.DeactivationDisabled == nil {
 		return ErrEmptyProjectFields
 	}
 
@@ -40,6 +40,9 @@ var (
 
 	adminTokenDuration        time.Duration
 	housekeepingInterval      time.Duration
+	housekeepingErrorWindow   time.Duration
+	housekeepingConfirmWindow time.Duration
+	housekeepingDrainTimeout  time.Duration
 	clientDeactivateThreshold string
 
 	mongoConnectionURI     string
@@ -70,6 +73,13 @@ func newServerCmd() *cobra.Command {
 			conf.Backend.ProjectInfoCacheTTL = projectInfoCacheTTL.String()
 
 			conf.Housekeeping.Interval = housekeepingInterval.String()
+			conf.Housekeeping.ErrorLogSamplingWindow = housekeepingErrorWindow.String()
+			if housekeepingConfirmWindow > 0 {
+				conf.Housekeeping.DeactivationConfirmWindow = housekeepingConfirmWindow.String()
+			}
+			if housekeepingDrainTimeout > 0 {
+				conf.Housekeeping.ShutdownDrainTimeout = housekeepingDrainTimeout.String()
+			}
 
 			if mongoConnectionURI != "" {
 				conf.Mongo = &mongo.Config{
@@ -211,6 +221,30 @@ func init() {
 		server.DefaultHousekeepingProjectFetchSize,
 		"housekeeping project fetch size for a single housekeeping run",
 	)
+	cmd.Flags().DurationVar(
+		&housekeepingErrorWindow,
+		"housekeeping-error-log-sampling-window",
+		server.DefaultHousekeepingErrorLogSamplingWindow,
+		"window in which identical housekeeping errors are logged at most once",
+	)
+	cmd.Flags().DurationVar(
+		&housekeepingConfirmWindow,
+		"housekeeping-deactivation-confirm-window",
+		0,
+		"time a deactivation candidate stays pending before it is deactivated (0 deactivates at once)",
+	)
+	cmd.Flags().StringVar(
+		&conf.Housekeeping.UnsyncedClientPolicy,
+		"housekeeping-unsynced-client-policy",
+		server.DefaultHousekeepingUnsyncedClientPolicy,
+		"treatment of deactivation candidates with attached documents (skip or force)",
+	)
+	cmd.Flags().DurationVar(
+		&housekeepingDrainTimeout,
+		"housekeeping-shutdown-drain-timeout",
+		0,
+		"maximum time to wait for running housekeeping tasks on shutdown (0 cancels them at once)",
+	)
 	cmd.Flags().StringVar(
 		&mongoConnectionURI,
 		"mongo-connection-uri",
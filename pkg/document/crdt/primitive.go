@@ -17,14 +17,33 @@
 package crdt
 
 import (
+	"bytes"
 	"encoding/binary"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
+	"strconv"
+	"strings"
+	"sync"
 	gotime "time"
 
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
+// ErrInvalidJSON is returned when the given JSON value is not valid.
+var ErrInvalidJSON = errors.New("invalid json")
+
+// marshalBufferPool is a pool of buffers used to format numbers and dates in
+// Marshal. The buffers are only used transiently; the results are always
+// copied out so that they are never aliased by the returned values.
+var marshalBufferPool = sync.Pool{
+	New: func() interface{} {
+		buf := make([]byte, 0, 64)
+		return &buf
+	},
+}
+
 // ValueType represents the type of Primitive value.
 type ValueType int
 
@@ -38,6 +57,7 @@ const (
 	String
 	Bytes
 	Date
+	JSON
 )
 
 // ValueFromBytes parses the given bytes into value.
@@ -64,6 +84,8 @@ func ValueFromBytes(valueType ValueType, value []byte) (interface{}, error) {
 	case Date:
 		v := int64(binary.LittleEndian.Uint64(value))
 		return gotime.UnixMilli(v), nil
+	case JSON:
+		return json.RawMessage(value), nil
 	default:
 		return nil, ErrUnsupportedType
 	}
@@ -150,6 +172,21 @@ func NewPrimitive(value interface{}, createdAt *time.Ticket) (*Primitive, error)
 			value:     val,
 			createdAt: createdAt,
 		}, nil
+	case json.RawMessage:
+		// NOTE: The given JSON is stored in its compact form so that the
+		// same JSON value always has the same representation.
+		if !json.Valid(val) {
+			return nil, ErrInvalidJSON
+		}
+		compacted, err := json.Marshal(val)
+		if err != nil {
+			return nil, fmt.Errorf("compact json: %w", err)
+		}
+		return &Primitive{
+			valueType: JSON,
+			value:     json.RawMessage(compacted),
+			createdAt: createdAt,
+		}, nil
 	default:
 		return nil, ErrUnsupportedType
 	}
@@ -187,6 +224,8 @@ func (p *Primitive) Bytes() []byte {
 		bytes := [8]byte{}
 		binary.LittleEndian.PutUint64(bytes[:], uint64(val.UTC().UnixMilli()))
 		return bytes[:]
+	case json.RawMessage:
+		return val
 	default:
 		return nil
 	}
@@ -199,25 +238,47 @@ func (p *Primitive) Marshal() string {
 		return "null"
 	case Boolean:
 		return fmt.Sprintf("%t", p.value)
-	case Integer:
-		return fmt.Sprintf("%d", p.value)
-	case Long:
-		return fmt.Sprintf("%d", p.value)
-	case Double:
-		return fmt.Sprintf("%f", p.value)
+	case Integer, Long, Double, Date:
+		return p.marshalWithBuffer()
 	case String:
 		return fmt.Sprintf(`"%s"`, EscapeString(p.value.(string)))
 	case Bytes:
 		// TODO: JSON.stringify({a: new Uint8Array([1,2]), b: 2})
 		// {"a":{"0":1,"1":2},"b":2}
 		return fmt.Sprintf(`"%s"`, p.value)
-	case Date:
-		return fmt.Sprintf(`"%s"`, p.value.(gotime.Time).Format(gotime.RFC3339))
+	case JSON:
+		return string(p.value.(json.RawMessage))
 	default:
 		return ""
 	}
 }
 
+// marshalWithBuffer formats numbers and dates using a pooled buffer.
+func (p *Primitive) marshalWithBuffer() string {
+	bufPtr := marshalBufferPool.Get().(*[]byte)
+	buf := (*bufPtr)[:0]
+
+	switch val := p.value.(type) {
+	case int32:
+		buf = strconv.AppendInt(buf, int64(val), 10)
+	case int64:
+		buf = strconv.AppendInt(buf, val, 10)
+	case float64:
+		// NOTE: This is the same format as fmt's %f verb.
+		buf = strconv.AppendFloat(buf, val, 'f', 6, 64)
+	case gotime.Time:
+		buf = append(buf, '"')
+		buf = val.AppendFormat(buf, gotime.RFC3339)
+		buf = append(buf, '"')
+	}
+
+	// NOTE: string(buf) copies the buffer, so it can be reused safely.
+	result := string(buf)
+	*bufPtr = buf
+	marshalBufferPool.Put(bufPtr)
+	return result
+}
+
 // DeepCopy copies itself deeply.
 func (p *Primitive) DeepCopy() (Element, error) {
 	primitive := *p
@@ -274,3 +335,127 @@ func (p *Primitive) IsNumericType() bool {
 	t := p.valueType
 	return t == Integer || t == Long || t == Double
 }
+
+// Equal returns whether the value of this primitive equals to the given one.
+// Numeric values are compared by their numbers regardless of their types, so
+// Integer 5, Long 5 and Double 5.0 are equal.
+func (p *Primitive) Equal(other *Primitive) bool {
+	return p.Compare(other) == 0
+}
+
+// Compare returns an integer comparing the values of two primitives.
+// The result will be 0 if p==other, -1 if p < other, and +1 if p > other.
+// Numeric values are compared by their numbers, and values of different
+// non-numeric types are ordered by their ValueType.
+func (p *Primitive) Compare(other *Primitive) int {
+	if p.IsNumericType() && other.IsNumericType() {
+		return compareNumbers(p.value, other.value)
+	}
+
+	if p.valueType != other.valueType {
+		if p.valueType < other.valueType {
+			return -1
+		}
+		return 1
+	}
+
+	switch p.valueType {
+	case Null:
+		return 0
+	case Boolean:
+		left, right := p.value.(bool), other.value.(bool)
+		if left == right {
+			return 0
+		}
+		if !left {
+			return -1
+		}
+		return 1
+	case String:
+		return strings.Compare(p.value.(string), other.value.(string))
+	case Bytes:
+		return bytes.Compare(p.value.([]byte), other.value.([]byte))
+	case Date:
+		return p.value.(gotime.Time).Compare(other.value.(gotime.Time))
+	case JSON:
+		return bytes.Compare(p.value.(json.RawMessage), other.value.(json.RawMessage))
+	default:
+		return 0
+	}
+}
+
+// compareNumbers compares two numeric values. Integral values are compared
+// without converting them to float64 so that large Long values keep their
+// precision. NaN is less than any other number and equal to itself.
+func compareNumbers(left, right interface{}) int {
+	leftInt, leftIsInt := toInt64(left)
+	rightInt, rightIsInt := toInt64(right)
+
+	switch {
+	case leftIsInt && rightIsInt:
+		return compareInt64s(leftInt, rightInt)
+	case leftIsInt:
+		return -compareFloat64WithInt64(right.(float64), leftInt)
+	case rightIsInt:
+		return compareFloat64WithInt64(left.(float64), rightInt)
+	}
+
+	leftFloat, rightFloat := left.(float64), right.(float64)
+	switch {
+	case math.IsNaN(leftFloat) && math.IsNaN(rightFloat):
+		return 0
+	case math.IsNaN(leftFloat) || leftFloat < rightFloat:
+		return -1
+	case math.IsNaN(rightFloat) || leftFloat > rightFloat:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// compareFloat64WithInt64 compares the given float64 with the given int64
+// without losing the precision of the int64.
+func compareFloat64WithInt64(f float64, i int64) int {
+	switch {
+	case math.IsNaN(f) || f < math.MinInt64:
+		return -1
+	case f >= math.MaxInt64:
+		return 1
+	}
+
+	truncated := math.Trunc(f)
+	if result := compareInt64s(int64(truncated), i); result != 0 {
+		return result
+	}
+
+	switch {
+	case f > truncated:
+		return 1
+	case f < truncated:
+		return -1
+	default:
+		return 0
+	}
+}
+
+func compareInt64s(left, right int64) int {
+	switch {
+	case left < right:
+		return -1
+	case left > right:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func toInt64(value interface{}) (int64, bool) {
+	switch val := value.(type) {
+	case int32:
+		return int64(val), true
+	case int64:
+		return val, true
+	default:
+		return 0, false
+	}
+}
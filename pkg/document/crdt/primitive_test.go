@@ -17,6 +17,7 @@
 package crdt_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"testing"
@@ -70,4 +71,107 @@ func TestPrimitive(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, longPrim.ValueType(), crdt.Long)
 	})
+	t.Run("json test", func(t *testing.T) {
+		tests := []struct {
+			value   string
+			marshal string
+		}{
+			{`null`, `null`},
+			{`"str"`, `"str"`},
+			{`[1, 2, 3]`, `[1,2,3]`},
+			{`{"a": 1, "b": "2"}`, `{"a":1,"b":"2"}`},
+			{`{"a": {"b": [1, {"c": true}], "d": null}}`, `{"a":{"b":[1,{"c":true}],"d":null}}`},
+			{"[\n  {\"a\": []},\n  {}\n]", `[{"a":[]},{}]`},
+		}
+
+		for _, test := range tests {
+			prim, err := crdt.NewPrimitive(json.RawMessage(test.value), time.InitialTicket)
+			assert.NoError(t, err)
+			assert.Equal(t, crdt.JSON, prim.ValueType())
+			assert.Equal(t, test.marshal, prim.Marshal())
+			assert.Equal(t, []byte(test.marshal), prim.Bytes())
+
+			value, err := crdt.ValueFromBytes(prim.ValueType(), prim.Bytes())
+			assert.NoError(t, err)
+			assert.Equal(t, prim.Value(), value)
+		}
+
+		for _, invalid := range []string{``, `{`, `{"a":}`, `[1, 2`, `undefined`} {
+			_, err := crdt.NewPrimitive(json.RawMessage(invalid), time.InitialTicket)
+			assert.ErrorIs(t, err, crdt.ErrInvalidJSON)
+		}
+	})
+	t.Run("marshal with pooled buffer test", func(t *testing.T) {
+		tests := []struct {
+			value   interface{}
+			marshal string
+		}{
+			{int32(-2147483648), "-2147483648"},
+			{int64(9007199254740993), "9007199254740993"},
+			{1.5, "1.500000"},
+			{math.Inf(1), "+Inf"},
+			{gotime.Date(2024, 1, 2, 3, 4, 5, 0, gotime.UTC), `"2024-01-02T03:04:05Z"`},
+		}
+
+		var marshaled []string
+		for _, test := range tests {
+			prim, err := crdt.NewPrimitive(test.value, time.InitialTicket)
+			assert.NoError(t, err)
+			assert.Equal(t, test.marshal, prim.Marshal())
+			marshaled = append(marshaled, prim.Marshal())
+		}
+
+		// NOTE: The results of previous calls should not be overwritten by
+		// the later calls that reuse the same buffer.
+		for i, test := range tests {
+			assert.Equal(t, test.marshal, marshaled[i])
+		}
+	})
+	t.Run("equality and comparison test", func(t *testing.T) {
+		newPrimitive := func(value interface{}) *crdt.Primitive {
+			prim, err := crdt.NewPrimitive(value, time.InitialTicket)
+			assert.NoError(t, err)
+			return prim
+		}
+
+		tests := []struct {
+			left     interface{}
+			right    interface{}
+			expected int
+		}{
+			{int32(5), int64(5), 0},
+			{int32(5), 5.0, 0},
+			{int64(5), 5.0, 0},
+			{int32(5), 5.5, -1},
+			{int64(-5), -5.5, 1},
+			{int64(6), 5.5, 1},
+			{int64(9007199254740993), float64(9007199254740992), 1},
+			{int64(math.MaxInt64), math.Inf(1), -1},
+			{int64(math.MinInt64), math.Inf(-1), 1},
+			{math.NaN(), int32(0), -1},
+			{math.NaN(), math.NaN(), 0},
+			{1.5, 2.5, -1},
+		}
+
+		for _, test := range tests {
+			left, right := newPrimitive(test.left), newPrimitive(test.right)
+			assert.Equal(t, test.expected, left.Compare(right), "%v <=> %v", test.left, test.right)
+			assert.Equal(t, -test.expected, right.Compare(left), "%v <=> %v", test.right, test.left)
+			assert.Equal(t, test.expected == 0, left.Equal(right))
+		}
+
+		// NOTE: The value types are kept even if the values are equal.
+		assert.Equal(t, crdt.Integer, newPrimitive(5).ValueType())
+		assert.Equal(t, crdt.Long, newPrimitive(int64(5)).ValueType())
+
+		// NOTE: Values of different non-numeric types are never equal.
+		assert.True(t, newPrimitive(nil).Equal(newPrimitive(nil)))
+		assert.True(t, newPrimitive("a").Equal(newPrimitive("a")))
+		assert.True(t, newPrimitive([]byte{1}).Equal(newPrimitive([]byte{1})))
+		assert.True(t, newPrimitive(gotime.UnixMilli(1)).Equal(newPrimitive(gotime.UnixMilli(1))))
+		assert.Equal(t, -1, newPrimitive(false).Compare(newPrimitive(true)))
+		assert.Equal(t, -1, newPrimitive("a").Compare(newPrimitive("b")))
+		assert.False(t, newPrimitive("5").Equal(newPrimitive(5)))
+		assert.Equal(t, -1, newPrimitive(nil).Compare(newPrimitive(false)))
+	})
 }
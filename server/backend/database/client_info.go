@@ -83,6 +83,10 @@ type ClientInfo struct {
 	// NOTE(hackerwins): The field name is "updated_at" but it is used as
 	// "accessed_at".
 	UpdatedAt time.Time `bson:"updated_at"`
+
+	// PendingDeactivationAt is the time when the client was marked as pending
+	// deactivation by housekeeping. It is nil if the client is not marked.
+	PendingDeactivationAt *time.Time `bson:"pending_deactivation_at,omitempty"`
 }
 
 // CheckIfInProject checks if the client is in the project.
@@ -99,6 +103,25 @@ func (i *ClientInfo) CheckIfInProject(projectID types.ID) error {
 	return nil
 }
 
+// IsPendingDeactivation returns whether the client has been marked as pending
+// deactivation and has not been accessed since then.
+func (i *ClientInfo) IsPendingDeactivation() bool {
+	return i.PendingDeactivationAt != nil && !i.UpdatedAt.After(*i.PendingDeactivationAt)
+}
+
+// HasAttachedDocuments returns whether the client has attached documents.
+// The client may still have local changes of those documents that are not
+// pushed to the server yet.
+func (i *ClientInfo) HasAttachedDocuments() bool {
+	for _, info := range i.Documents {
+		if info.Status == DocumentAttached {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Deactivate sets the status of this client to be deactivated.
 func (i *ClientInfo) Deactivate() {
 	i.Status = ClientDeactivated
@@ -260,14 +283,21 @@ func (i *ClientInfo) DeepCopy() *ClientInfo {
 		}
 	}
 
+	var pendingDeactivationAt *time.Time
+	if i.PendingDeactivationAt != nil {
+		markedAt := *i.PendingDeactivationAt
+		pendingDeactivationAt = &markedAt
+	}
+
 	return &ClientInfo{
-		ID:        i.ID,
-		ProjectID: i.ProjectID,
-		Key:       i.Key,
-		Status:    i.Status,
-		Documents: documents,
-		CreatedAt: i.CreatedAt,
-		UpdatedAt: i.UpdatedAt,
+		ID:                    i.ID,
+		ProjectID:             i.ProjectID,
+		Key:                   i.Key,
+		Status:                i.Status,
+		Documents:             documents,
+		CreatedAt:             i.CreatedAt,
+		UpdatedAt:             i.UpdatedAt,
+		PendingDeactivationAt: pendingDeactivationAt,
 	}
 }
 
@@ -142,6 +142,10 @@ type Database interface {
 	// DeactivateClient deactivates the client of the given refKey.
 	DeactivateClient(ctx context.Context, refKey types.ClientRefKey) (*ClientInfo, error)
 
+	// MarkClientPendingDeactivation marks the activated client of the given
+	// refKey as pending deactivation without updating its access time.
+	MarkClientPendingDeactivation(ctx context.Context, refKey types.ClientRefKey) error
+
 	// FindClientInfoByRefKey finds the client of the given refKey.
 	FindClientInfoByRefKey(ctx context.Context, refKey types.ClientRefKey) (*ClientInfo, error)
 
@@ -157,6 +161,7 @@ type Database interface {
 	) ([]*ProjectInfo, error)
 
 	// FindDeactivateCandidatesPerProject finds the clients that need housekeeping per project.
+	// It returns no candidates if the deactivation of the project is disabled.
 	FindDeactivateCandidatesPerProject(
 		ctx context.Context,
 		project *ProjectInfo,
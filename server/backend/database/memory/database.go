@@ -586,6 +586,44 @@ func (d *DB) DeactivateClient(_ context.Context, refKey types.ClientRefKey) (*da
 	return clientInfo, nil
 }
 
+// MarkClientPendingDeactivation marks the client as pending deactivation.
+func (d *DB) MarkClientPendingDeactivation(_ context.Context, refKey types.ClientRefKey) error {
+	if err := refKey.ClientID.Validate(); err != nil {
+		return err
+	}
+
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First(tblClients, "id", refKey.ClientID.String())
+	if err != nil {
+		return fmt.Errorf("find client by id: %w", err)
+	}
+
+	if raw == nil {
+		return fmt.Errorf("%s: %w", refKey.ClientID, database.ErrClientNotFound)
+	}
+
+	clientInfo := raw.(*database.ClientInfo)
+	if err := clientInfo.CheckIfInProject(refKey.ProjectID); err != nil {
+		return err
+	}
+	if err := clientInfo.EnsureActivated(); err != nil {
+		return err
+	}
+
+	clientInfo = clientInfo.DeepCopy()
+	now := gotime.Now()
+	clientInfo.PendingDeactivationAt = &now
+
+	if err := txn.Insert(tblClients, clientInfo); err != nil {
+		return fmt.Errorf("update client: %w", err)
+	}
+
+	txn.Commit()
+	return nil
+}
+
 // FindClientInfoByRefKey finds a client by the given refKey.
 func (d *DB) FindClientInfoByRefKey(_ context.Context, refKey types.ClientRefKey) (*database.ClientInfo, error) {
 	if err := refKey.ClientID.Validate(); err != nil {
@@ -679,6 +717,10 @@ func (d *DB) FindDeactivateCandidatesPerProject(
 	project *database.ProjectInfo,
 	candidatesLimit int,
 ) ([]*database.ClientInfo, error) {
+	if project.DeactivationDisabled {
+		return nil, nil
+	}
+
 	txn := d.db.Txn(false)
 	defer txn.Abort()
 
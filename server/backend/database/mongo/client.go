@@ -519,6 +519,9 @@ func (c *Client) ActivateClient(ctx context.Context, projectID types.ID, key str
 			"status":     database.ClientActivated,
 			"updated_at": now,
 		},
+		"$unset": bson.M{
+			"pending_deactivation_at": "",
+		},
 	}, options.Update().SetUpsert(true))
 	if err != nil {
 		return nil, fmt.Errorf("upsert client: %w", err)
@@ -596,6 +599,27 @@ func (c *Client) DeactivateClient(ctx context.Context, refKey types.ClientRefKey
 	return &clientInfo, nil
 }
 
+// MarkClientPendingDeactivation marks the client as pending deactivation.
+func (c *Client) MarkClientPendingDeactivation(ctx context.Context, refKey types.ClientRefKey) error {
+	res, err := c.collection(ColClients).UpdateOne(ctx, bson.M{
+		"project_id": refKey.ProjectID,
+		"_id":        refKey.ClientID,
+		"status":     database.ClientActivated,
+	}, bson.M{
+		"$set": bson.M{
+			"pending_deactivation_at": gotime.Now(),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("mark client pending deactivation: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%s: %w", refKey.ClientID, database.ErrClientNotFound)
+	}
+
+	return nil
+}
+
 // FindClientInfoByRefKey finds the client of the given refKey.
 func (c *Client) FindClientInfoByRefKey(ctx context.Context, refKey types.ClientRefKey) (*database.ClientInfo, error) {
 	result := c.collection(ColClients).FindOneAndUpdate(ctx, bson.M{
@@ -678,6 +702,10 @@ func (c *Client) FindDeactivateCandidatesPerProject(
 	project *database.ProjectInfo,
 	candidatesLimit int,
 ) ([]*database.ClientInfo, error) {
+	if project.DeactivationDisabled {
+		return nil, nil
+	}
+
 	clientDeactivateThreshold, err := project.ClientDeactivateThresholdAsTimeDuration()
 	if err != nil {
 		return nil, err
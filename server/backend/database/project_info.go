@@ -61,6 +61,10 @@ type ProjectInfo struct {
 	// specific project are considered deactivate for housekeeping.
 	ClientDeactivateThreshold string `bson:"client_deactivate_threshold"`
 
+	// DeactivationDisabled is whether the clients in this project are
+	// excluded from the deactivation of housekeeping.
+	DeactivationDisabled bool `bson:"deactivation_disabled"`
+
 	// CreatedAt is the time when the project was created.
 	CreatedAt time.Time `bson:"created_at"`
 
@@ -96,6 +100,7 @@ func (i *ProjectInfo) DeepCopy() *ProjectInfo {
 		AuthWebhookURL:            i.AuthWebhookURL,
 		AuthWebhookMethods:        i.AuthWebhookMethods,
 		ClientDeactivateThreshold: i.ClientDeactivateThreshold,
+		DeactivationDisabled:      i.DeactivationDisabled,
 		CreatedAt:                 i.CreatedAt,
 		UpdatedAt:                 i.UpdatedAt,
 	}
@@ -115,6 +120,9 @@ func (i *ProjectInfo) UpdateFields(fields *types.UpdatableProjectFields) {
 	if fields.ClientDeactivateThreshold != nil {
 		i.ClientDeactivateThreshold = *fields.ClientDeactivateThreshold
 	}
+	if fields.DeactivationDisabled != nil {
+		i.DeactivationDisabled = *fields.DeactivationDisabled
+	}
 }
 
 // ToProject converts the ProjectInfo to the Project.
@@ -126,6 +134,7 @@ func (i *ProjectInfo) ToProject() *types.Project {
 		AuthWebhookURL:            i.AuthWebhookURL,
 		AuthWebhookMethods:        i.AuthWebhookMethods,
 		ClientDeactivateThreshold: i.ClientDeactivateThreshold,
+		DeactivationDisabled:      i.DeactivationDisabled,
 		PublicKey:                 i.PublicKey,
 		SecretKey:                 i.SecretKey,
 		CreatedAt:                 i.CreatedAt,
@@ -498,6 +498,31 @@ func RunActivateClientDeactivateClientTest(t *testing.T, db database.Database, p
 		assert.Equal(t, database.ClientDeactivated, clientInfo.Status)
 	})
 
+	t.Run("mark client pending deactivation test", func(t *testing.T) {
+		ctx := context.Background()
+
+		clientInfo, err := db.ActivateClient(ctx, projectID, t.Name())
+		assert.NoError(t, err)
+		assert.False(t, clientInfo.IsPendingDeactivation())
+
+		// 01. Mark the client and check that its access time is not updated.
+		assert.NoError(t, db.MarkClientPendingDeactivation(ctx, clientInfo.RefKey()))
+		marked, err := db.FindClientInfoByRefKey(ctx, clientInfo.RefKey())
+		assert.NoError(t, err)
+		assert.True(t, marked.IsPendingDeactivation())
+		assert.Equal(t, clientInfo.UpdatedAt.Unix(), marked.UpdatedAt.Unix())
+
+		// 02. Activating the client again clears the mark.
+		clientInfo, err = db.ActivateClient(ctx, projectID, t.Name())
+		assert.NoError(t, err)
+		assert.False(t, clientInfo.IsPendingDeactivation())
+
+		// 03. Deactivated clients can not be marked.
+		_, err = db.DeactivateClient(ctx, clientInfo.RefKey())
+		assert.NoError(t, err)
+		assert.Error(t, db.MarkClientPendingDeactivation(ctx, clientInfo.RefKey()))
+	})
+
 	t.Run("ensure document detached when deactivate client test", func(t *testing.T) {
 		ctx := context.Background()
 
@@ -1400,6 +1425,47 @@ func RunFindDeactivateCandidatesPerProjectTest(t *testing.T, db database.Databas
 		assert.Contains(t, idList, c1.ID)
 		assert.Contains(t, idList, c2.ID)
 	})
+
+	t.Run("FindDeactivateCandidatesPerProject deactivation disabled test", func(t *testing.T) {
+		ctx := context.Background()
+
+		p1, err := db.CreateProjectInfo(
+			ctx,
+			fmt.Sprintf("%s-DeactivationDisabled", t.Name()),
+			otherOwnerID,
+			"0s",
+		)
+		assert.NoError(t, err)
+		disabled := true
+		p1, err = db.UpdateProjectInfo(ctx, otherOwnerID, p1.ID, &types.UpdatableProjectFields{
+			DeactivationDisabled: &disabled,
+		})
+		assert.NoError(t, err)
+		assert.True(t, p1.DeactivationDisabled)
+
+		_, err = db.ActivateClient(ctx, p1.ID, t.Name()+"1-1")
+		assert.NoError(t, err)
+
+		p2, err := db.CreateProjectInfo(
+			ctx,
+			fmt.Sprintf("%s-DeactivationEnabled", t.Name()),
+			otherOwnerID,
+			"0s",
+		)
+		assert.NoError(t, err)
+
+		c2, err := db.ActivateClient(ctx, p2.ID, t.Name()+"2-1")
+		assert.NoError(t, err)
+
+		candidates1, err := db.FindDeactivateCandidatesPerProject(ctx, p1, 10)
+		assert.NoError(t, err)
+		assert.Len(t, candidates1, 0)
+
+		candidates2, err := db.FindDeactivateCandidatesPerProject(ctx, p2, 10)
+		assert.NoError(t, err)
+		assert.Len(t, candidates2, 1)
+		assert.Equal(t, c2.ID, candidates2[0].ID)
+	})
 }
 
 // AssertKeys checks the equivalence between the provided expectedKeys and the keys in the given infos.
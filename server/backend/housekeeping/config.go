@@ -23,6 +23,16 @@ import (
 	"time"
 )
 
+const (
+	// UnsyncedClientPolicySkip is the policy that skips the deactivation of
+	// clients that may have changes not yet synchronized.
+	UnsyncedClientPolicySkip = "skip"
+
+	// UnsyncedClientPolicyForce is the policy that deactivates clients
+	// regardless of whether they may have changes not yet synchronized.
+	UnsyncedClientPolicyForce = "force"
+)
+
 // Config is the configuration for the housekeeping service.
 type Config struct {
 	// Interval is the time between housekeeping runs.
@@ -33,6 +43,24 @@ type Config struct {
 
 	// ProjectFetchSize is the maximum number of projects to be returned to deactivate candidates.
 	ProjectFetchSize int `yaml:"HousekeepingProjectFetchSize"`
+
+	// ErrorLogSamplingWindow is the window in which identical errors of a task
+	// are logged at most once. If it is empty, every error is logged.
+	ErrorLogSamplingWindow string `yaml:"ErrorLogSamplingWindow"`
+
+	// DeactivationConfirmWindow is the time a candidate stays pending
+	// deactivation before it is actually deactivated. If it is empty, the
+	// candidates are deactivated as soon as they are found.
+	DeactivationConfirmWindow string `yaml:"DeactivationConfirmWindow"`
+
+	// UnsyncedClientPolicy is the treatment of candidates that still have
+	// attached documents, "skip" or "force". If it is empty, "force" is used.
+	UnsyncedClientPolicy string `yaml:"UnsyncedClientPolicy"`
+
+	// ShutdownDrainTimeout is the maximum time to wait for the running tasks
+	// to finish their fetched candidates when the service stops. If it is
+	// empty, the running tasks are canceled at once.
+	ShutdownDrainTimeout string `yaml:"ShutdownDrainTimeout"`
 }
 
 // Validate validates the configuration.
@@ -59,6 +87,39 @@ func (c *Config) Validate() error {
 		)
 	}
 
+	if _, err := c.ParseErrorLogSamplingWindow(); err != nil {
+		return fmt.Errorf(
+			`invalid argument %s for "--housekeeping-error-log-sampling-window" flag: %w`,
+			c.ErrorLogSamplingWindow,
+			err,
+		)
+	}
+
+	if _, err := c.ParseDeactivationConfirmWindow(); err != nil {
+		return fmt.Errorf(
+			`invalid argument %s for "--housekeeping-deactivation-confirm-window" flag: %w`,
+			c.DeactivationConfirmWindow,
+			err,
+		)
+	}
+
+	if _, err := c.ParseShutdownDrainTimeout(); err != nil {
+		return fmt.Errorf(
+			`invalid argument %s for "--housekeeping-shutdown-drain-timeout" flag: %w`,
+			c.ShutdownDrainTimeout,
+			err,
+		)
+	}
+
+	if c.UnsyncedClientPolicy != "" &&
+		c.UnsyncedClientPolicy != UnsyncedClientPolicySkip &&
+		c.UnsyncedClientPolicy != UnsyncedClientPolicyForce {
+		return fmt.Errorf(
+			`invalid argument %s for "--housekeeping-unsynced-client-policy" flag`,
+			c.UnsyncedClientPolicy,
+		)
+	}
+
 	return nil
 }
 
@@ -71,3 +132,48 @@ func (c *Config) ParseInterval() (time.Duration, error) {
 
 	return interval, nil
 }
+
+// ParseErrorLogSamplingWindow parses the error log sampling window. It returns
+// zero if the window is not set.
+func (c *Config) ParseErrorLogSamplingWindow() (time.Duration, error) {
+	if c.ErrorLogSamplingWindow == "" {
+		return 0, nil
+	}
+
+	window, err := time.ParseDuration(c.ErrorLogSamplingWindow)
+	if err != nil {
+		return 0, fmt.Errorf("parse error log sampling window %s: %w", c.ErrorLogSamplingWindow, err)
+	}
+
+	return window, nil
+}
+
+// ParseDeactivationConfirmWindow parses the deactivation confirm window. It
+// returns zero if the window is not set.
+func (c *Config) ParseDeactivationConfirmWindow() (time.Duration, error) {
+	if c.DeactivationConfirmWindow == "" {
+		return 0, nil
+	}
+
+	window, err := time.ParseDuration(c.DeactivationConfirmWindow)
+	if err != nil {
+		return 0, fmt.Errorf("parse deactivation confirm window %s: %w", c.DeactivationConfirmWindow, err)
+	}
+
+	return window, nil
+}
+
+// ParseShutdownDrainTimeout parses the shutdown drain timeout. It returns zero
+// if the timeout is not set.
+func (c *Config) ParseShutdownDrainTimeout() (time.Duration, error) {
+	if c.ShutdownDrainTimeout == "" {
+		return 0, nil
+	}
+
+	timeout, err := time.ParseDuration(c.ShutdownDrainTimeout)
+	if err != nil {
+		return 0, fmt.Errorf("parse shutdown drain timeout %s: %w", c.ShutdownDrainTimeout, err)
+	}
+
+	return timeout, nil
+}
@@ -44,5 +44,29 @@ func TestConfig(t *testing.T) {
 		conf3 := validConf
 		conf3.ProjectFetchSize = -1
 		assert.Error(t, conf3.Validate())
+
+		conf4 := validConf
+		conf4.ErrorLogSamplingWindow = "minute"
+		assert.Error(t, conf4.Validate())
+
+		conf5 := validConf
+		conf5.ErrorLogSamplingWindow = "30s"
+		assert.NoError(t, conf5.Validate())
+
+		conf6 := validConf
+		conf6.DeactivationConfirmWindow = "day"
+		assert.Error(t, conf6.Validate())
+
+		conf7 := validConf
+		conf7.UnsyncedClientPolicy = "ignore"
+		assert.Error(t, conf7.Validate())
+
+		conf8 := validConf
+		conf8.UnsyncedClientPolicy = housekeeping.UnsyncedClientPolicySkip
+		assert.NoError(t, conf8.Validate())
+
+		conf9 := validConf
+		conf9.ShutdownDrainTimeout = "soon"
+		assert.Error(t, conf9.Validate())
 	})
 }
@@ -0,0 +1,55 @@
+/*
+ * Copyright 2024 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package housekeeping
+
+import (
+	"fmt"
+
+	"github.com/yorkie-team/yorkie/api/types"
+)
+
+// CycleError is the error returned when a cycle of a housekeeping task fails.
+// It tells the caller where the cycle stopped.
+type CycleError struct {
+	// Task is the name of the task that failed.
+	Task string
+
+	// ProjectID is the ID of the project being processed when the task failed.
+	ProjectID types.ID
+
+	// Processed is the number of candidates processed before the failure.
+	Processed int
+
+	// Err is the underlying error.
+	Err error
+}
+
+// Error returns the error message.
+func (e *CycleError) Error() string {
+	return fmt.Sprintf(
+		"%s: project %s, processed %d: %s",
+		e.Task,
+		e.ProjectID,
+		e.Processed,
+		e.Err,
+	)
+}
+
+// Unwrap returns the underlying error.
+func (e *CycleError) Unwrap() error {
+	return e.Err
+}
@@ -18,46 +18,127 @@ package housekeeping
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/go-co-op/gocron/v2"
 
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/server/backend/database"
 	"github.com/yorkie-team/yorkie/server/logging"
 )
 
+var (
+	// ErrTaskAlreadyRegistered is returned when the task of the given name is
+	// already registered.
+	ErrTaskAlreadyRegistered = errors.New("task already registered")
+
+	// ErrTaskNotFound is returned when the task of the given name is not
+	// registered.
+	ErrTaskNotFound = errors.New("task not found")
+)
+
 // Housekeeping is the housekeeping service. It periodically runs housekeeping
 // tasks.
 type Housekeeping struct {
 	Config *Config
 
-	scheduler gocron.Scheduler
+	scheduler              gocron.Scheduler
+	errorLogSamplingWindow time.Duration
+	shutdownDrainTimeout   time.Duration
+
+	// ctx is passed to the tasks, and is canceled when the service stops.
+	ctx        context.Context
+	cancelFunc context.CancelFunc
+
+	// running is the number of running tasks. stopping is set when the
+	// service starts to stop so that no more tasks are run.
+	runningMu sync.Mutex
+	running   sync.WaitGroup
+	stopping  bool
+
+	// cursors is the map of the task name to the ID of the project where the
+	// next cycle of the task starts scanning.
+	cursorsMu sync.Mutex
+	cursors   map[string]types.ID
 }
 
 // New creates a new housekeeping instance.
 func New(conf *Config) (*Housekeeping, error) {
+	window, err := conf.ParseErrorLogSamplingWindow()
+	if err != nil {
+		return nil, err
+	}
+
+	drainTimeout, err := conf.ParseShutdownDrainTimeout()
+	if err != nil {
+		return nil, err
+	}
+
 	scheduler, err := gocron.NewScheduler()
 	if err != nil {
 		return nil, fmt.Errorf("new scheduler: %w", err)
 	}
 
+	ctx, cancelFunc := context.WithCancel(context.Background())
+
 	return &Housekeeping{
-		Config:    conf,
-		scheduler: scheduler,
+		Config:                 conf,
+		scheduler:              scheduler,
+		errorLogSamplingWindow: window,
+		shutdownDrainTimeout:   drainTimeout,
+		ctx:                    ctx,
+		cancelFunc:             cancelFunc,
+		cursors:                make(map[string]types.ID),
 	}, nil
 }
 
-// RegisterTask registers task the housekeeping service.
+// RegisterTask registers task of the given name to the housekeeping service.
 func (h *Housekeeping) RegisterTask(
+	name string,
 	interval time.Duration,
 	task func(ctx context.Context) error,
 ) error {
+	h.cursorsMu.Lock()
+	if _, ok := h.cursors[name]; ok {
+		h.cursorsMu.Unlock()
+		return fmt.Errorf("%s: %w", name, ErrTaskAlreadyRegistered)
+	}
+	h.cursors[name] = database.DefaultProjectID
+	h.cursorsMu.Unlock()
+
+	sampler := newErrorSampler(h.errorLogSamplingWindow)
 	if _, err := h.scheduler.NewJob(
 		gocron.DurationJob(interval),
 		gocron.NewTask(func() {
-			ctx := context.Background()
+			h.runningMu.Lock()
+			if h.stopping {
+				h.runningMu.Unlock()
+				return
+			}
+			h.running.Add(1)
+			h.runningMu.Unlock()
+			defer h.running.Done()
+
+			ctx := h.ctx
 			if err := task(ctx); err != nil {
-				logging.From(ctx).Error(err)
+				// NOTE: The error of a task canceled by Stop is not logged.
+				if errors.Is(err, context.Canceled) && ctx.Err() != nil {
+					return
+				}
+
+				logged, suppressed := sampler.Sample(err)
+				if !logged {
+					return
+				}
+
+				if suppressed > 0 {
+					logging.From(ctx).Errorf("%v (suppressed %d identical errors)", err, suppressed)
+				} else {
+					logging.From(ctx).Error(err)
+				}
 			}
 		}),
 	); err != nil {
@@ -73,8 +154,32 @@ func (h *Housekeeping) Start() error {
 	return nil
 }
 
-// Stop stops the housekeeping service.
+// Stop stops the housekeeping service. If the shutdown drain timeout is set,
+// it waits for the running tasks to finish until the timeout before canceling
+// them.
 func (h *Housekeeping) Stop() error {
+	h.runningMu.Lock()
+	h.stopping = true
+	h.runningMu.Unlock()
+
+	if h.shutdownDrainTimeout > 0 {
+		drained := make(chan struct{})
+		go func() {
+			h.running.Wait()
+			close(drained)
+		}()
+
+		select {
+		case <-drained:
+		case <-time.After(h.shutdownDrainTimeout):
+			logging.DefaultLogger().Warnf(
+				"HSKP: running tasks are not drained in %s, cancel them",
+				h.shutdownDrainTimeout,
+			)
+		}
+	}
+	h.cancelFunc()
+
 	if err := h.scheduler.StopJobs(); err != nil {
 		return fmt.Errorf("scheduler stop jobs: %w", err)
 	}
@@ -85,3 +190,39 @@ func (h *Housekeeping) Stop() error {
 
 	return nil
 }
+
+// Cursor returns the ID of the project where the next cycle of the given task
+// starts scanning.
+func (h *Housekeeping) Cursor(task string) types.ID {
+	h.cursorsMu.Lock()
+	defer h.cursorsMu.Unlock()
+
+	return h.cursors[task]
+}
+
+// SetCursor sets the ID of the project where the next cycle of the given task
+// starts scanning. It is used to re-scan a specific project without waiting
+// for a full cycle.
+func (h *Housekeeping) SetCursor(task string, projectID types.ID) error {
+	h.cursorsMu.Lock()
+	defer h.cursorsMu.Unlock()
+
+	if _, ok := h.cursors[task]; !ok {
+		return fmt.Errorf("%s: %w", task, ErrTaskNotFound)
+	}
+
+	h.cursors[task] = projectID
+	return nil
+}
+
+// AdvanceCursor moves the cursor of the given task from the given project to
+// the next one after a cycle. If the cursor has been changed by SetCursor
+// during the cycle, it is kept so that the next cycle starts from there.
+func (h *Housekeeping) AdvanceCursor(task string, from, to types.ID) {
+	h.cursorsMu.Lock()
+	defer h.cursorsMu.Unlock()
+
+	if cursor, ok := h.cursors[task]; ok && cursor == from {
+		h.cursors[task] = to
+	}
+}
@@ -0,0 +1,146 @@
+/*
+ * Copyright 2024 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package housekeeping_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/backend/housekeeping"
+)
+
+const testTask = "test"
+
+func newHousekeeping(t *testing.T, drainTimeout ...string) *housekeeping.Housekeeping {
+	conf := &housekeeping.Config{
+		Interval:                  "10ms",
+		CandidatesLimitPerProject: 10,
+		ProjectFetchSize:          10,
+	}
+	if len(drainTimeout) > 0 {
+		conf.ShutdownDrainTimeout = drainTimeout[0]
+	}
+
+	h, err := housekeeping.New(conf)
+	assert.NoError(t, err)
+
+	return h
+}
+
+func TestHousekeeping(t *testing.T) {
+	t.Run("register task test", func(t *testing.T) {
+		h := newHousekeeping(t)
+
+		task := func(ctx context.Context) error { return nil }
+		assert.NoError(t, h.RegisterTask(testTask, 10*time.Millisecond, task))
+		assert.ErrorIs(t, h.RegisterTask(testTask, 10*time.Millisecond, task), housekeeping.ErrTaskAlreadyRegistered)
+		assert.Equal(t, database.DefaultProjectID, h.Cursor(testTask))
+	})
+
+	t.Run("set cursor test", func(t *testing.T) {
+		h := newHousekeeping(t)
+		projectID := types.ID("000000000000000000000123")
+
+		cursors := make(chan types.ID, 10)
+		assert.NoError(t, h.RegisterTask(testTask, 10*time.Millisecond, func(ctx context.Context) error {
+			cursor := h.Cursor(testTask)
+			cursors <- cursor
+			h.AdvanceCursor(testTask, cursor, database.DefaultProjectID)
+			return nil
+		}))
+		assert.ErrorIs(t, h.SetCursor("unknown", projectID), housekeeping.ErrTaskNotFound)
+		assert.NoError(t, h.SetCursor(testTask, projectID))
+
+		assert.NoError(t, h.Start())
+		defer func() {
+			assert.NoError(t, h.Stop())
+		}()
+
+		select {
+		case cursor := <-cursors:
+			assert.Equal(t, projectID, cursor)
+		case <-time.After(time.Second):
+			assert.Fail(t, "task is not run")
+		}
+	})
+
+	t.Run("advance cursor after set cursor test", func(t *testing.T) {
+		h := newHousekeeping(t)
+		projectID := types.ID("000000000000000000000123")
+		nextProjectID := types.ID("000000000000000000000456")
+
+		assert.NoError(t, h.RegisterTask(testTask, time.Hour, func(ctx context.Context) error {
+			return nil
+		}))
+
+		// NOTE: A cycle started from the default project ends after the cursor
+		// is set, so the cursor set during the cycle should be kept.
+		assert.NoError(t, h.SetCursor(testTask, projectID))
+		h.AdvanceCursor(testTask, database.DefaultProjectID, nextProjectID)
+		assert.Equal(t, projectID, h.Cursor(testTask))
+
+		h.AdvanceCursor(testTask, projectID, nextProjectID)
+		assert.Equal(t, nextProjectID, h.Cursor(testTask))
+	})
+	t.Run("drain on stop test", func(t *testing.T) {
+		for _, drainTimeout := range []string{"", "1s"} {
+			h := newHousekeeping(t, drainTimeout)
+
+			// NOTE: Each run of the task processes a batch of candidates and
+			// stops processing when the context is canceled.
+			const batchSize = 5
+			var runs, processed atomic.Int32
+			started := make(chan struct{}, 1)
+			assert.NoError(t, h.RegisterTask(testTask, 10*time.Millisecond, func(ctx context.Context) error {
+				runs.Add(1)
+				select {
+				case started <- struct{}{}:
+				default:
+				}
+
+				for i := 0; i < batchSize; i++ {
+					if err := ctx.Err(); err != nil {
+						return err
+					}
+					time.Sleep(20 * time.Millisecond)
+					processed.Add(1)
+				}
+				return nil
+			}))
+
+			assert.NoError(t, h.Start())
+			select {
+			case <-started:
+			case <-time.After(time.Second):
+				assert.Fail(t, "task is not run")
+			}
+			assert.NoError(t, h.Stop())
+
+			if drainTimeout == "" {
+				assert.Less(t, processed.Load(), runs.Load()*batchSize)
+			} else {
+				assert.Equal(t, runs.Load()*batchSize, processed.Load())
+			}
+		}
+	})
+}
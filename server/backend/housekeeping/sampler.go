@@ -0,0 +1,74 @@
+/*
+ * Copyright 2024 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package housekeeping
+
+import (
+	"sync"
+	"time"
+)
+
+// errorSampler decides whether an error of a task should be logged. Identical
+// errors are logged at most once per window so that a flapping dependency
+// such as the database does not flood the logs, while distinct errors are
+// always logged immediately.
+type errorSampler struct {
+	mu sync.Mutex
+
+	window time.Duration
+	now    func() time.Time
+
+	lastErr      string
+	lastLoggedAt time.Time
+	suppressed   int
+}
+
+// newErrorSampler creates a new instance of errorSampler. If the given window
+// is not positive, every error is logged.
+func newErrorSampler(window time.Duration) *errorSampler {
+	return &errorSampler{
+		window: window,
+		now:    time.Now,
+	}
+}
+
+// Sample returns whether the given error should be logged and, if so, the
+// number of identical errors suppressed since it was logged last time.
+func (s *errorSampler) Sample(err error) (bool, int) {
+	if s.window <= 0 {
+		return true, 0
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	msg := err.Error()
+	if msg != s.lastErr || now.Sub(s.lastLoggedAt) >= s.window {
+		suppressed := 0
+		if msg == s.lastErr {
+			suppressed = s.suppressed
+		}
+
+		s.lastErr = msg
+		s.lastLoggedAt = now
+		s.suppressed = 0
+		return true, suppressed
+	}
+
+	s.suppressed++
+	return false, 0
+}
@@ -0,0 +1,84 @@
+/*
+ * Copyright 2024 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package housekeeping
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorSampler(t *testing.T) {
+	t.Run("suppress identical errors test", func(t *testing.T) {
+		now := time.Now()
+		sampler := newErrorSampler(time.Minute)
+		sampler.now = func() time.Time { return now }
+
+		errDB := errors.New("connection refused")
+
+		logged, suppressed := sampler.Sample(errDB)
+		assert.True(t, logged)
+		assert.Equal(t, 0, suppressed)
+
+		for i := 0; i < 5; i++ {
+			now = now.Add(time.Second)
+			logged, _ = sampler.Sample(errDB)
+			assert.False(t, logged)
+		}
+
+		now = now.Add(time.Minute)
+		logged, suppressed = sampler.Sample(errDB)
+		assert.True(t, logged)
+		assert.Equal(t, 5, suppressed)
+	})
+
+	t.Run("log distinct errors immediately test", func(t *testing.T) {
+		now := time.Now()
+		sampler := newErrorSampler(time.Minute)
+		sampler.now = func() time.Time { return now }
+
+		errA := errors.New("error A")
+		errB := errors.New("error B")
+
+		logged, _ := sampler.Sample(errA)
+		assert.True(t, logged)
+		logged, _ = sampler.Sample(errA)
+		assert.False(t, logged)
+
+		logged, suppressed := sampler.Sample(errB)
+		assert.True(t, logged)
+		assert.Equal(t, 0, suppressed)
+		logged, _ = sampler.Sample(errB)
+		assert.False(t, logged)
+
+		logged, _ = sampler.Sample(errA)
+		assert.True(t, logged)
+	})
+
+	t.Run("disabled sampling test", func(t *testing.T) {
+		sampler := newErrorSampler(0)
+		errDB := errors.New("connection refused")
+
+		for i := 0; i < 3; i++ {
+			logged, suppressed := sampler.Sample(errDB)
+			assert.True(t, logged)
+			assert.Equal(t, 0, suppressed)
+		}
+	})
+}
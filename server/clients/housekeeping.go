@@ -23,10 +23,15 @@ import (
 	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/server/backend"
 	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/backend/housekeeping"
 	"github.com/yorkie-team/yorkie/server/logging"
 )
 
 const (
+	// DeactivateInactivesTask is the name of the housekeeping task that
+	// deactivates inactive clients.
+	DeactivateInactivesTask = "deactivateInactives"
+
 	deactivateCandidatesKey = "housekeeping/deactivateCandidates"
 )
 
@@ -67,20 +72,17 @@ func DeactivateInactives(
 		return database.DefaultProjectID, err
 	}
 
-	deactivatedCount := 0
-	for _, clientInfo := range candidates {
-		if _, err := Deactivate(ctx, be.DB, clientInfo.RefKey()); err != nil {
-			return database.DefaultProjectID, err
-		}
-
-		deactivatedCount++
+	deactivatedCount, pendingCount, err := deactivateCandidates(ctx, be, candidates)
+	if err != nil {
+		return database.DefaultProjectID, err
 	}
 
 	if len(candidates) > 0 {
 		logging.From(ctx).Infof(
-			"HSKP: candidates %d, deactivated %d, %s",
+			"HSKP: candidates %d, deactivated %d, pending %d, %s",
 			len(candidates),
 			deactivatedCount,
+			pendingCount,
 			time.Since(start),
 		)
 	}
@@ -88,6 +90,120 @@ func DeactivateInactives(
 	return lastProjectID, nil
 }
 
+// DeactivateInactivesOfProject deactivates inactive clients of the given
+// project only, without advancing the cycling scan over the projects. It
+// returns the number of deactivated clients.
+func DeactivateInactivesOfProject(
+	ctx context.Context,
+	be *backend.Backend,
+	projectID types.ID,
+	candidatesLimit int,
+) (int, error) {
+	locker, err := be.Coordinator.NewLocker(ctx, deactivateCandidatesKey)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := locker.Lock(ctx); err != nil {
+		return 0, err
+	}
+
+	defer func() {
+		if err := locker.Unlock(ctx); err != nil {
+			logging.From(ctx).Error(err)
+		}
+	}()
+
+	project, err := be.DB.FindProjectInfoByID(ctx, projectID)
+	if err != nil {
+		return 0, err
+	}
+
+	candidates, err := be.DB.FindDeactivateCandidatesPerProject(ctx, project, candidatesLimit)
+	if err != nil {
+		return 0, err
+	}
+
+	deactivatedCount, _, err := deactivateCandidates(ctx, be, candidates)
+	if err != nil {
+		return deactivatedCount, err
+	}
+
+	return deactivatedCount, nil
+}
+
+// deactivateCandidates deactivates the given candidates and returns the number
+// of deactivated and pending clients. If it fails, the returned error is a
+// housekeeping.CycleError.
+func deactivateCandidates(
+	ctx context.Context,
+	be *backend.Backend,
+	candidates []*database.ClientInfo,
+) (int, int, error) {
+	confirmWindow, err := be.Housekeeping.Config.ParseDeactivationConfirmWindow()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	skipUnsynced := be.Housekeeping.Config.UnsyncedClientPolicy == housekeeping.UnsyncedClientPolicySkip
+
+	deactivatedCount, pendingCount := 0, 0
+	for _, clientInfo := range candidates {
+		if err := ctx.Err(); err != nil {
+			return deactivatedCount, pendingCount, &housekeeping.CycleError{
+				Task:      DeactivateInactivesTask,
+				ProjectID: clientInfo.ProjectID,
+				Processed: deactivatedCount + pendingCount,
+				Err:       err,
+			}
+		}
+
+		if skipUnsynced && clientInfo.HasAttachedDocuments() {
+			logging.From(ctx).Infof(
+				"HSKP: skip deactivating client %s with attached documents",
+				clientInfo.ID,
+			)
+			continue
+		}
+
+		// NOTE: If the confirm window is set, a candidate is only marked as
+		// pending in the first cycle, and is deactivated in a later cycle if it
+		// has not been accessed during the window.
+		if confirmWindow > 0 {
+			if !clientInfo.IsPendingDeactivation() {
+				if err := be.DB.MarkClientPendingDeactivation(ctx, clientInfo.RefKey()); err != nil {
+					return deactivatedCount, pendingCount, &housekeeping.CycleError{
+						Task:      DeactivateInactivesTask,
+						ProjectID: clientInfo.ProjectID,
+						Processed: deactivatedCount + pendingCount,
+						Err:       err,
+					}
+				}
+				pendingCount++
+				continue
+			}
+
+			if time.Since(*clientInfo.PendingDeactivationAt) < confirmWindow {
+				pendingCount++
+				continue
+			}
+		}
+
+		if _, err := Deactivate(ctx, be.DB, clientInfo.RefKey()); err != nil {
+			return deactivatedCount, pendingCount, &housekeeping.CycleError{
+				Task:      DeactivateInactivesTask,
+				ProjectID: clientInfo.ProjectID,
+				Processed: deactivatedCount + pendingCount,
+				Err:       err,
+			}
+		}
+
+		deactivatedCount++
+	}
+
+	return deactivatedCount, pendingCount, nil
+}
+
 // FindDeactivateCandidates finds candidates to deactivate from the database.
 func FindDeactivateCandidates(
 	ctx context.Context,
@@ -0,0 +1,330 @@
+/*
+ * Copyright 2024 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clients_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	gotime "time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/backend/housekeeping"
+	"github.com/yorkie-team/yorkie/server/backend/sync"
+	"github.com/yorkie-team/yorkie/server/clients"
+	"github.com/yorkie-team/yorkie/server/profiling/prometheus"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+var (
+	dummyOwnerID = types.ID("000000000000000000000000")
+	errDummy     = errors.New("dummy error")
+)
+
+// failingDB is a database that fails to deactivate clients after the given
+// number of successful deactivations.
+type failingDB struct {
+	database.Database
+	failAfter int
+}
+
+func (d *failingDB) DeactivateClient(ctx context.Context, refKey types.ClientRefKey) (*database.ClientInfo, error) {
+	if d.failAfter == 0 {
+		return nil, errDummy
+	}
+	d.failAfter--
+
+	return d.Database.DeactivateClient(ctx, refKey)
+}
+
+func setupBackend(t *testing.T, coordinator sync.Coordinator) *backend.Backend {
+	conf := helper.TestConfig()
+
+	metrics, err := prometheus.NewMetrics()
+	assert.NoError(t, err)
+
+	be, err := backend.New(conf.Backend, nil, conf.Housekeeping, metrics)
+	assert.NoError(t, err)
+	be.Coordinator = coordinator
+
+	return be
+}
+
+// activateInactiveClients creates a project whose clients are deactivation
+// candidates as soon as they are activated, and activates n clients in it.
+func activateInactiveClients(t *testing.T, be *backend.Backend, n int, name ...string) *database.ProjectInfo {
+	ctx := context.Background()
+
+	projectName := t.Name()
+	if len(name) > 0 {
+		projectName = name[0]
+	}
+
+	project, err := be.DB.CreateProjectInfo(ctx, projectName, dummyOwnerID, "0s")
+	assert.NoError(t, err)
+
+	for i := 0; i < n; i++ {
+		_, err := be.DB.ActivateClient(ctx, project.ID, fmt.Sprintf("%s-%d", t.Name(), i))
+		assert.NoError(t, err)
+	}
+
+	return project
+}
+
+func TestDeactivateInactives(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("deactivate inactive clients test", func(t *testing.T) {
+		coordinator := helper.NewFakeCoordinator(helper.Lockable)
+		be := setupBackend(t, coordinator)
+		defer func() { assert.NoError(t, be.Shutdown()) }()
+
+		project := activateInactiveClients(t, be, 3)
+
+		_, err := clients.DeactivateInactives(ctx, be, 10, 10, database.DefaultProjectID)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, coordinator.LockCount())
+		assert.Equal(t, 1, coordinator.UnlockCount())
+
+		candidates, err := be.DB.FindDeactivateCandidatesPerProject(ctx, project, 10)
+		assert.NoError(t, err)
+		assert.Len(t, candidates, 0)
+	})
+
+	t.Run("lock failure test", func(t *testing.T) {
+		coordinator := helper.NewFakeCoordinator(helper.FailOnLock)
+		be := setupBackend(t, coordinator)
+		defer func() { assert.NoError(t, be.Shutdown()) }()
+
+		project := activateInactiveClients(t, be, 3)
+
+		_, err := clients.DeactivateInactives(ctx, be, 10, 10, database.DefaultProjectID)
+		assert.ErrorIs(t, err, helper.ErrFakeLock)
+		assert.Equal(t, 0, coordinator.UnlockCount())
+
+		candidates, err := be.DB.FindDeactivateCandidatesPerProject(ctx, project, 10)
+		assert.NoError(t, err)
+		assert.Len(t, candidates, 3)
+	})
+
+	t.Run("lock already held test", func(t *testing.T) {
+		coordinator := helper.NewFakeCoordinator(helper.AlreadyHeld)
+		be := setupBackend(t, coordinator)
+		defer func() { assert.NoError(t, be.Shutdown()) }()
+
+		project := activateInactiveClients(t, be, 3)
+
+		_, err := clients.DeactivateInactives(ctx, be, 10, 10, database.DefaultProjectID)
+		assert.ErrorIs(t, err, sync.ErrAlreadyLocked)
+		assert.Equal(t, 0, coordinator.UnlockCount())
+
+		candidates, err := be.DB.FindDeactivateCandidatesPerProject(ctx, project, 10)
+		assert.NoError(t, err)
+		assert.Len(t, candidates, 3)
+	})
+
+	t.Run("unlock failure test", func(t *testing.T) {
+		coordinator := helper.NewFakeCoordinator(helper.FailOnUnlock)
+		be := setupBackend(t, coordinator)
+		defer func() { assert.NoError(t, be.Shutdown()) }()
+
+		project := activateInactiveClients(t, be, 3)
+
+		// NOTE: The failure of the deferred unlock is only logged, so the
+		// deactivation itself should be treated as successful.
+		_, err := clients.DeactivateInactives(ctx, be, 10, 10, database.DefaultProjectID)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, coordinator.UnlockCount())
+
+		candidates, err := be.DB.FindDeactivateCandidatesPerProject(ctx, project, 10)
+		assert.NoError(t, err)
+		assert.Len(t, candidates, 0)
+	})
+
+	t.Run("deactivation disabled project test", func(t *testing.T) {
+		be := setupBackend(t, helper.NewFakeCoordinator(helper.Lockable))
+		defer func() { assert.NoError(t, be.Shutdown()) }()
+
+		disabledProject := activateInactiveClients(t, be, 2, "disabled")
+		disabled := true
+		disabledProject, err := be.DB.UpdateProjectInfo(ctx, dummyOwnerID, disabledProject.ID, &types.UpdatableProjectFields{
+			DeactivationDisabled: &disabled,
+		})
+		assert.NoError(t, err)
+		normalProject := activateInactiveClients(t, be, 2, "normal")
+
+		_, err = clients.DeactivateInactives(ctx, be, 10, 10, database.DefaultProjectID)
+		assert.NoError(t, err)
+
+		candidates, err := be.DB.FindDeactivateCandidatesPerProject(ctx, normalProject, 10)
+		assert.NoError(t, err)
+		assert.Len(t, candidates, 0)
+
+		disabledProject.DeactivationDisabled = false
+		candidates, err = be.DB.FindDeactivateCandidatesPerProject(ctx, disabledProject, 10)
+		assert.NoError(t, err)
+		assert.Len(t, candidates, 2)
+	})
+	t.Run("soft deactivation test", func(t *testing.T) {
+		be := setupBackend(t, helper.NewFakeCoordinator(helper.Lockable))
+		defer func() { assert.NoError(t, be.Shutdown()) }()
+		be.Housekeeping.Config.DeactivationConfirmWindow = "50ms"
+
+		project := activateInactiveClients(t, be, 2)
+		candidates, err := be.DB.FindDeactivateCandidatesPerProject(ctx, project, 10)
+		assert.NoError(t, err)
+		assert.Len(t, candidates, 2)
+		reconnected, confirmed := candidates[0], candidates[1]
+
+		// 01. The first cycle only marks the candidates as pending.
+		_, err = clients.DeactivateInactives(ctx, be, 10, 10, database.DefaultProjectID)
+		assert.NoError(t, err)
+		for _, candidate := range candidates {
+			info, err := be.DB.FindClientInfoByRefKey(ctx, candidate.RefKey())
+			assert.NoError(t, err)
+			assert.Equal(t, database.ClientActivated, info.Status)
+			assert.True(t, info.IsPendingDeactivation())
+		}
+
+		// 02. A cycle within the confirm window does not deactivate them.
+		_, err = clients.DeactivateInactives(ctx, be, 10, 10, database.DefaultProjectID)
+		assert.NoError(t, err)
+		info, err := be.DB.FindClientInfoByRefKey(ctx, confirmed.RefKey())
+		assert.NoError(t, err)
+		assert.Equal(t, database.ClientActivated, info.Status)
+
+		// 03. A client that reconnects clears the pending mark.
+		info, err = be.DB.ActivateClient(ctx, project.ID, reconnected.Key)
+		assert.NoError(t, err)
+		assert.False(t, info.IsPendingDeactivation())
+
+		// 04. After the confirm window, only the client that has not been
+		// accessed is deactivated.
+		gotime.Sleep(60 * gotime.Millisecond)
+		_, err = clients.DeactivateInactives(ctx, be, 10, 10, database.DefaultProjectID)
+		assert.NoError(t, err)
+
+		info, err = be.DB.FindClientInfoByRefKey(ctx, confirmed.RefKey())
+		assert.NoError(t, err)
+		assert.Equal(t, database.ClientDeactivated, info.Status)
+
+		info, err = be.DB.FindClientInfoByRefKey(ctx, reconnected.RefKey())
+		assert.NoError(t, err)
+		assert.Equal(t, database.ClientActivated, info.Status)
+		assert.True(t, info.IsPendingDeactivation())
+	})
+	t.Run("deactivate inactives of project test", func(t *testing.T) {
+		coordinator := helper.NewFakeCoordinator(helper.Lockable)
+		be := setupBackend(t, coordinator)
+		defer func() { assert.NoError(t, be.Shutdown()) }()
+
+		target := activateInactiveClients(t, be, 3, "target")
+		other := activateInactiveClients(t, be, 2, "other")
+
+		deactivated, err := clients.DeactivateInactivesOfProject(ctx, be, target.ID, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, deactivated)
+		assert.Equal(t, 1, coordinator.LockCount())
+		assert.Equal(t, 1, coordinator.UnlockCount())
+
+		candidates, err := be.DB.FindDeactivateCandidatesPerProject(ctx, target, 10)
+		assert.NoError(t, err)
+		assert.Len(t, candidates, 0)
+
+		candidates, err = be.DB.FindDeactivateCandidatesPerProject(ctx, other, 10)
+		assert.NoError(t, err)
+		assert.Len(t, candidates, 2)
+
+		_, err = clients.DeactivateInactivesOfProject(ctx, be, types.ID("000000000000000000000001"), 10)
+		assert.ErrorIs(t, err, database.ErrProjectNotFound)
+	})
+	t.Run("unsynced client policy test", func(t *testing.T) {
+		for _, policy := range []string{
+			housekeeping.UnsyncedClientPolicySkip,
+			housekeeping.UnsyncedClientPolicyForce,
+		} {
+			t.Run(policy, func(t *testing.T) {
+				be := setupBackend(t, helper.NewFakeCoordinator(helper.Lockable))
+				defer func() { assert.NoError(t, be.Shutdown()) }()
+				be.Housekeeping.Config.UnsyncedClientPolicy = policy
+
+				project := activateInactiveClients(t, be, 2)
+				candidates, err := be.DB.FindDeactivateCandidatesPerProject(ctx, project, 10)
+				assert.NoError(t, err)
+				assert.Len(t, candidates, 2)
+				unsynced, synced := candidates[0], candidates[1]
+
+				docInfo, err := be.DB.FindDocInfoByKeyAndOwner(ctx, unsynced.RefKey(), helper.TestDocKey(t), true)
+				assert.NoError(t, err)
+				assert.NoError(t, unsynced.AttachDocument(docInfo.ID, false))
+				assert.NoError(t, be.DB.UpdateClientInfoAfterPushPull(ctx, unsynced, docInfo))
+
+				_, err = clients.DeactivateInactives(ctx, be, 10, 10, database.DefaultProjectID)
+				assert.NoError(t, err)
+
+				info, err := be.DB.FindClientInfoByRefKey(ctx, synced.RefKey())
+				assert.NoError(t, err)
+				assert.Equal(t, database.ClientDeactivated, info.Status)
+
+				expected := database.ClientDeactivated
+				if policy == housekeeping.UnsyncedClientPolicySkip {
+					expected = database.ClientActivated
+				}
+				info, err = be.DB.FindClientInfoByRefKey(ctx, unsynced.RefKey())
+				assert.NoError(t, err)
+				assert.Equal(t, expected, info.Status)
+			})
+		}
+	})
+	t.Run("cycle error test", func(t *testing.T) {
+		be := setupBackend(t, helper.NewFakeCoordinator(helper.Lockable))
+		defer func() { assert.NoError(t, be.Shutdown()) }()
+
+		project := activateInactiveClients(t, be, 3)
+		be.DB = &failingDB{Database: be.DB, failAfter: 1}
+
+		_, err := clients.DeactivateInactives(ctx, be, 10, 10, database.DefaultProjectID)
+		assert.ErrorIs(t, err, errDummy)
+
+		var cycleErr *housekeeping.CycleError
+		assert.ErrorAs(t, err, &cycleErr)
+		assert.Equal(t, clients.DeactivateInactivesTask, cycleErr.Task)
+		assert.Equal(t, project.ID, cycleErr.ProjectID)
+		assert.Equal(t, 1, cycleErr.Processed)
+	})
+
+	t.Run("canceled context test", func(t *testing.T) {
+		be := setupBackend(t, helper.NewFakeCoordinator(helper.Lockable))
+		defer func() { assert.NoError(t, be.Shutdown()) }()
+
+		project := activateInactiveClients(t, be, 3)
+
+		canceledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err := clients.DeactivateInactives(canceledCtx, be, 10, 10, database.DefaultProjectID)
+		assert.ErrorIs(t, err, context.Canceled)
+
+		candidates, err := be.DB.FindDeactivateCandidatesPerProject(ctx, project, 10)
+		assert.NoError(t, err)
+		assert.Len(t, candidates, 3)
+	})
+}
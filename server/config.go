@@ -43,6 +43,8 @@ const (
 	DefaultHousekeepingInterval                  = 30 * time.Second
 	DefaultHousekeepingCandidatesLimitPerProject = 500
 	DefaultHousekeepingProjectFetchSize          = 100
+	DefaultHousekeepingErrorLogSamplingWindow    = 1 * time.Minute
+	DefaultHousekeepingUnsyncedClientPolicy      = housekeeping.UnsyncedClientPolicyForce
 
 	DefaultMongoConnectionURI     = "mongodb://localhost:27017"
 	DefaultMongoConnectionTimeout = 5 * time.Second
@@ -232,6 +234,8 @@ func newConfig(port int, profilingPort int) *Config {
 			Interval:                  DefaultHousekeepingInterval.String(),
 			CandidatesLimitPerProject: DefaultHousekeepingCandidatesLimitPerProject,
 			ProjectFetchSize:          DefaultHousekeepingProjectFetchSize,
+			ErrorLogSamplingWindow:    DefaultHousekeepingErrorLogSamplingWindow.String(),
+			UnsyncedClientPolicy:      DefaultHousekeepingUnsyncedClientPolicy,
 		},
 		Backend: &backend.Config{
 			ClientDeactivateThreshold:  DefaultClientDeactivateThreshold,
@@ -26,7 +26,6 @@ import (
 	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/client"
 	"github.com/yorkie-team/yorkie/server/backend"
-	"github.com/yorkie-team/yorkie/server/backend/database"
 	"github.com/yorkie-team/yorkie/server/clients"
 	"github.com/yorkie-team/yorkie/server/profiling"
 	"github.com/yorkie-team/yorkie/server/profiling/prometheus"
@@ -164,8 +163,8 @@ func (r *Yorkie) RegisterHousekeepingTasks(be *backend.Backend) error {
 		return err
 	}
 
-	housekeepingLastProjectID := database.DefaultProjectID
-	return be.Housekeeping.RegisterTask(interval, func(ctx context.Context) error {
+	return be.Housekeeping.RegisterTask(clients.DeactivateInactivesTask, interval, func(ctx context.Context) error {
+		housekeepingLastProjectID := be.Housekeeping.Cursor(clients.DeactivateInactivesTask)
 		lastProjectID, err := clients.DeactivateInactives(
 			ctx,
 			be,
@@ -177,11 +176,22 @@ func (r *Yorkie) RegisterHousekeepingTasks(be *backend.Backend) error {
 			return err
 		}
 
-		housekeepingLastProjectID = lastProjectID
+		be.Housekeeping.AdvanceCursor(clients.DeactivateInactivesTask, housekeepingLastProjectID, lastProjectID)
 		return nil
 	})
 }
 
+// RunDeactivateForProject deactivates inactive clients of the given project
+// only. It is used for maintenance and testing.
+func (r *Yorkie) RunDeactivateForProject(ctx context.Context, projectID types.ID) (int, error) {
+	return clients.DeactivateInactivesOfProject(
+		ctx,
+		r.backend,
+		projectID,
+		r.backend.Housekeeping.Config.CandidatesLimitPerProject,
+	)
+}
+
 // DefaultProject returns the default project.
 func (r *Yorkie) DefaultProject(ctx context.Context) (*types.Project, error) {
 	return projects.GetProjectFromAPIKey(ctx, r.backend, "")
@@ -0,0 +1,108 @@
+//go:build bench
+
+/*
+ * Copyright 2024 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bench
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/clients"
+	"github.com/yorkie-team/yorkie/server/profiling/prometheus"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+// setUpHousekeepingBackend creates a backend with the memory database seeded
+// with the given number of projects, each of which has the given number of
+// deactivation candidates.
+func setUpHousekeepingBackend(b *testing.B, projectCount, candidateCount int) *backend.Backend {
+	conf := helper.TestConfig()
+	conf.Backend.UseDefaultProject = false
+
+	metrics, err := prometheus.NewMetrics()
+	assert.NoError(b, err)
+
+	be, err := backend.New(conf.Backend, nil, conf.Housekeeping, metrics)
+	assert.NoError(b, err)
+
+	ctx := context.Background()
+	owner := types.ID("000000000000000000000000")
+	for i := 0; i < projectCount; i++ {
+		project, err := be.DB.CreateProjectInfo(ctx, fmt.Sprintf("project-%d", i), owner, "0s")
+		assert.NoError(b, err)
+
+		for j := 0; j < candidateCount; j++ {
+			_, err := be.DB.ActivateClient(ctx, project.ID, fmt.Sprintf("client-%d-%d", i, j))
+			assert.NoError(b, err)
+		}
+	}
+
+	return be
+}
+
+// benchmarkFindDeactivateCandidates measures a full cycle of scanning, which
+// fetches projects page by page until every project has been visited once.
+func benchmarkFindDeactivateCandidates(
+	b *testing.B,
+	projectCount int,
+	candidateCount int,
+	projectFetchSize int,
+) {
+	be := setUpHousekeepingBackend(b, projectCount, candidateCount)
+	defer func() {
+		assert.NoError(b, be.Shutdown())
+	}()
+
+	ctx := context.Background()
+	pages := (projectCount + projectFetchSize - 1) / projectFetchSize
+
+	b.ResetTimer()
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		lastProjectID := database.DefaultProjectID
+		for j := 0; j < pages; j++ {
+			var err error
+			lastProjectID, _, err = clients.FindDeactivateCandidates(
+				ctx,
+				be,
+				candidateCount,
+				projectFetchSize,
+				lastProjectID,
+			)
+			assert.NoError(b, err)
+		}
+	}
+}
+
+func BenchmarkHousekeeping(b *testing.B) {
+	for _, projectCount := range []int{10, 100} {
+		for _, candidateCount := range []int{10, 100} {
+			name := fmt.Sprintf("FindDeactivateCandidates %d projects %d candidates", projectCount, candidateCount)
+			b.Run(name, func(b *testing.B) {
+				benchmarkFindDeactivateCandidates(b, projectCount, candidateCount, 10)
+			})
+		}
+	}
+}
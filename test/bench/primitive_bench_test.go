@@ -0,0 +1,79 @@
+//go:build bench
+
+/*
+ * Copyright 2024 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bench
+
+import (
+	"testing"
+	gotime "time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+func BenchmarkPrimitive(b *testing.B) {
+	values := []interface{}{
+		int32(2147483647),
+		int64(9007199254740993),
+		3.141592,
+		gotime.Date(2024, 1, 2, 3, 4, 5, 0, gotime.UTC),
+	}
+
+	var primitives []*crdt.Primitive
+	for _, value := range values {
+		prim, err := crdt.NewPrimitive(value, time.InitialTicket)
+		assert.NoError(b, err)
+		primitives = append(primitives, prim)
+	}
+
+	b.Run("marshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for _, prim := range primitives {
+				_ = prim.Marshal()
+			}
+		}
+	})
+
+	b.Run("marshal parallel", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetParallelism(128)
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				for _, prim := range primitives {
+					_ = prim.Marshal()
+				}
+			}
+		})
+	})
+
+	b.Run("encode and decode parallel", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetParallelism(128)
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				for _, prim := range primitives {
+					_, err := crdt.ValueFromBytes(prim.ValueType(), prim.Bytes())
+					assert.NoError(b, err)
+				}
+			}
+		})
+	})
+}
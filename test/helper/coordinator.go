@@ -0,0 +1,183 @@
+/*
+ * Copyright 2024 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package helper
+
+import (
+	"context"
+	"errors"
+	gosync "sync"
+	gotime "time"
+
+	"github.com/yorkie-team/yorkie/server/backend/sync"
+	"github.com/yorkie-team/yorkie/server/backend/sync/memory"
+)
+
+var (
+	// ErrFakeLock is returned by the locker of FakeCoordinator in FailOnLock mode.
+	ErrFakeLock = errors.New("fake lock failure")
+
+	// ErrFakeUnlock is returned by the locker of FakeCoordinator in FailOnUnlock mode.
+	ErrFakeUnlock = errors.New("fake unlock failure")
+)
+
+// LockerMode is the behavior of the lockers created by FakeCoordinator.
+type LockerMode int
+
+const (
+	// Lockable lockers are locked and unlocked normally.
+	Lockable LockerMode = iota
+
+	// FailOnLock lockers return ErrFakeLock on Lock and TryLock.
+	FailOnLock
+
+	// FailOnUnlock lockers are locked normally but return ErrFakeUnlock on Unlock.
+	FailOnUnlock
+
+	// AlreadyHeld lockers behave as if the lock is held by another server and
+	// return sync.ErrAlreadyLocked on Lock and TryLock.
+	AlreadyHeld
+)
+
+// FakeCoordinator is a sync.Coordinator for testing. It delegates everything
+// except lockers to the memory coordinator, and creates lockers that follow
+// the given LockerMode so that the failure paths of the callers can be tested.
+type FakeCoordinator struct {
+	sync.Coordinator
+
+	mu          gosync.Mutex
+	mode        LockerMode
+	lockCount   int
+	unlockCount int
+}
+
+// NewFakeCoordinator creates a new instance of FakeCoordinator.
+func NewFakeCoordinator(mode LockerMode) *FakeCoordinator {
+	return &FakeCoordinator{
+		Coordinator: memory.NewCoordinator(&sync.ServerInfo{
+			ID:        "fake-server",
+			Hostname:  "localhost",
+			UpdatedAt: gotime.Now(),
+		}),
+		mode: mode,
+	}
+}
+
+// SetMode changes the mode of the lockers created by this coordinator.
+func (c *FakeCoordinator) SetMode(mode LockerMode) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.mode = mode
+}
+
+// LockCount returns the number of successful Lock or TryLock calls.
+func (c *FakeCoordinator) LockCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.lockCount
+}
+
+// UnlockCount returns the number of Unlock calls, including failed ones.
+func (c *FakeCoordinator) UnlockCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.unlockCount
+}
+
+// NewLocker creates a fake locker of the given key.
+func (c *FakeCoordinator) NewLocker(ctx context.Context, key sync.Key) (sync.Locker, error) {
+	locker, err := c.Coordinator.NewLocker(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fakeLocker{
+		coordinator: c,
+		locker:      locker,
+	}, nil
+}
+
+type fakeLocker struct {
+	coordinator *FakeCoordinator
+	locker      sync.Locker
+}
+
+// Lock locks the mutex according to the mode of the coordinator.
+func (l *fakeLocker) Lock(ctx context.Context) error {
+	if err := l.failure(); err != nil {
+		return err
+	}
+
+	if err := l.locker.Lock(ctx); err != nil {
+		return err
+	}
+
+	l.coordinator.mu.Lock()
+	l.coordinator.lockCount++
+	l.coordinator.mu.Unlock()
+	return nil
+}
+
+// TryLock locks the mutex according to the mode of the coordinator.
+func (l *fakeLocker) TryLock(ctx context.Context) error {
+	if err := l.failure(); err != nil {
+		return err
+	}
+
+	if err := l.locker.TryLock(ctx); err != nil {
+		return err
+	}
+
+	l.coordinator.mu.Lock()
+	l.coordinator.lockCount++
+	l.coordinator.mu.Unlock()
+	return nil
+}
+
+// Unlock unlocks the mutex according to the mode of the coordinator.
+func (l *fakeLocker) Unlock(ctx context.Context) error {
+	l.coordinator.mu.Lock()
+	l.coordinator.unlockCount++
+	mode := l.coordinator.mode
+	l.coordinator.mu.Unlock()
+
+	if err := l.locker.Unlock(ctx); err != nil {
+		return err
+	}
+
+	if mode == FailOnUnlock {
+		return ErrFakeUnlock
+	}
+
+	return nil
+}
+
+func (l *fakeLocker) failure() error {
+	l.coordinator.mu.Lock()
+	defer l.coordinator.mu.Unlock()
+
+	switch l.coordinator.mode {
+	case FailOnLock:
+		return ErrFakeLock
+	case AlreadyHeld:
+		return sync.ErrAlreadyLocked
+	default:
+		return nil
+	}
+}